@@ -19,6 +19,7 @@ func CreateHost(machineConfig config.MachineConfig) *hyperv.Driver {
 
 	if machineConfig.NetworkMode == network.VSockMode {
 		hypervDriver.VirtualSwitch = ""
+		hypervDriver.NoNetwork = true
 	} else {
 		// Determine the Virtual Switch to be used
 		_, switchName := winnet.SelectSwitchByNameOrDefault(AlternativeNetwork)